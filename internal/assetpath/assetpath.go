@@ -0,0 +1,136 @@
+// Package assetpath builds the on-disk filesystem paths manager writes to
+// directly - the asset directory itself, uploaded documents, and the
+// per-asset documents index - as a single typed layer. It complements
+// github.com/trustwallet/assets-go-libs/path, which resolves the paths of
+// catalog files (info.json, tokenlist.json) that manager only reads and
+// writes through that library.
+//
+// Every helper here routes through path/filepath so separators are
+// Windows-safe, and every untrusted path segment is cleaned and checked
+// against traversal before it is joined in, so a caller can never land
+// outside the asset directory it asked for.
+package assetpath
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	blockchainsDir         = "blockchains"
+	assetsDir              = "assets"
+	documentsIndexFilename = "documents.json"
+)
+
+// GetAssetDirPath returns the on-disk directory for tokenID under
+// chainHandle, e.g. blockchains/ethereum/assets/0x....
+func GetAssetDirPath(chainHandle, tokenID string) (string, error) {
+	if err := validatePathSegment(tokenID); err != nil {
+		return "", fmt.Errorf("invalid token id: %w", err)
+	}
+
+	return filepath.Join(blockchainsDir, chainHandle, assetsDir, tokenID), nil
+}
+
+// GetAssetDocumentPath returns the on-disk path for a document named
+// documentFilename inside tokenID's asset directory. documentFilename is
+// sanitized and rejected outright if it contains a path separator (forward
+// or backward) or a traversal token such as "..", so a caller can never use
+// it to escape the asset directory.
+func GetAssetDocumentPath(chainHandle, tokenID, documentFilename string) (string, error) {
+	assetDir, err := GetAssetDirPath(chainHandle, tokenID)
+	if err != nil {
+		return "", err
+	}
+
+	filename, err := sanitizeDocumentFilename(documentFilename)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(assetDir, filename), nil
+}
+
+// GetAssetDocumentsIndexPath returns the on-disk path of tokenID's
+// documents.json index.
+func GetAssetDocumentsIndexPath(chainHandle, tokenID string) (string, error) {
+	assetDir, err := GetAssetDirPath(chainHandle, tokenID)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(assetDir, documentsIndexFilename), nil
+}
+
+// ResolveImportEntryPath resolves entryName, an untrusted archive entry name
+// such as a tar header's Name, into an on-disk path under
+// blockchains/<chainHandle>. Like sanitizeDocumentFilename, entryName is
+// judged with path.Clean (not filepath.Clean) after normalizing backslashes
+// to forward slashes, since a malicious archive entry's separators must be
+// rejected the same way regardless of the platform manager runs on. Absolute
+// entries (Unix-rooted or Windows drive-letter) and entries that still
+// resolve to ".." or above are rejected outright, so a crafted or corrupted
+// archive can never be used to write files elsewhere on disk (a "tar-slip").
+func ResolveImportEntryPath(chainHandle, entryName string) (string, error) {
+	if entryName == "" {
+		return "", fmt.Errorf("archive entry name is empty")
+	}
+
+	normalized := strings.ReplaceAll(entryName, `\`, "/")
+	if path.IsAbs(normalized) || isWindowsDriveAbsolute(normalized) {
+		return "", fmt.Errorf("archive entry %q has an absolute path", entryName)
+	}
+
+	clean := path.Clean(normalized)
+	if clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", fmt.Errorf("archive entry %q escapes the chain directory", entryName)
+	}
+
+	return filepath.Join(blockchainsDir, chainHandle, filepath.FromSlash(clean)), nil
+}
+
+// isWindowsDriveAbsolute reports whether p begins with a Windows drive
+// letter such as "C:/", which path.IsAbs does not recognize since it only
+// understands Unix-rooted paths.
+func isWindowsDriveAbsolute(p string) bool {
+	if len(p) < 3 || p[1] != ':' || p[2] != '/' {
+		return false
+	}
+
+	c := p[0]
+
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func validatePathSegment(segment string) error {
+	if segment == "" {
+		return fmt.Errorf("path segment is empty")
+	}
+
+	clean := filepath.Clean(segment)
+	if clean != segment || clean == "." || clean == ".." || strings.ContainsAny(clean, `/\`) {
+		return fmt.Errorf("%q is not a valid single path segment", segment)
+	}
+
+	return nil
+}
+
+// sanitizeDocumentFilename normalizes documentFilename to a plain basename,
+// rejecting it if that changes its meaning - i.e. if it carried a forward or
+// backward slash, or a "." / ".." traversal token. path.Clean (not
+// filepath.Clean) is used deliberately: documentFilename is untrusted caller
+// input, not an OS path, so its separators must be judged the same way
+// regardless of the platform manager runs on.
+func sanitizeDocumentFilename(documentFilename string) (string, error) {
+	normalized := strings.ReplaceAll(documentFilename, `\`, `/`)
+	clean := path.Clean(normalized)
+	base := path.Base(clean)
+
+	if base == "" || base == "." || base == ".." || base != normalized {
+		return "", fmt.Errorf("invalid document filename: %q", documentFilename)
+	}
+
+	return base, nil
+}
@@ -0,0 +1,90 @@
+package assetpath
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGetAssetDocumentPath(t *testing.T) {
+	tests := []struct {
+		name             string
+		documentFilename string
+		wantErr          bool
+	}{
+		{name: "plain filename", documentFilename: "disclosure.pdf"},
+		{name: "unix traversal", documentFilename: "../../etc/passwd", wantErr: true},
+		{name: "windows-style traversal", documentFilename: `..\..\etc\passwd`, wantErr: true},
+		{name: "windows-style separator in filename", documentFilename: `sub\disclosure.pdf`, wantErr: true},
+		{name: "unix separator in filename", documentFilename: "sub/disclosure.pdf", wantErr: true},
+		{name: "bare traversal token", documentFilename: "..", wantErr: true},
+		{name: "current dir token", documentFilename: ".", wantErr: true},
+		{name: "empty filename", documentFilename: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetAssetDocumentPath("ethereum", "0xTOKEN", tt.documentFilename)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("GetAssetDocumentPath(%q) = %q, want error", tt.documentFilename, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetAssetDocumentPath(%q) returned unexpected error: %v", tt.documentFilename, err)
+			}
+
+			want := "blockchains/ethereum/assets/0xTOKEN/disclosure.pdf"
+			if filepathToSlash(got) != want {
+				t.Fatalf("GetAssetDocumentPath(%q) = %q, want %q", tt.documentFilename, got, want)
+			}
+		})
+	}
+}
+
+func TestGetAssetDirPath_RejectsTraversal(t *testing.T) {
+	tests := []string{"..", "../outside", "a/b", `a\b`, ""}
+
+	for _, tokenID := range tests {
+		if _, err := GetAssetDirPath("ethereum", tokenID); err == nil {
+			t.Fatalf("GetAssetDirPath(%q) = nil error, want error", tokenID)
+		}
+	}
+}
+
+func TestResolveImportEntryPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		entry   string
+		wantErr bool
+	}{
+		{name: "regular entry", entry: "assets/0xTOKEN/info.json"},
+		{name: "windows-style entry", entry: `assets\0xTOKEN\info.json`},
+		{name: "unix traversal", entry: "../../../../tmp/evil", wantErr: true},
+		{name: "windows-style traversal", entry: `..\..\..\..\tmp\evil`, wantErr: true},
+		{name: "absolute unix path", entry: "/etc/passwd", wantErr: true},
+		{name: "absolute windows path", entry: `C:\Windows\System32\evil`, wantErr: true},
+		{name: "empty entry", entry: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveImportEntryPath("ethereum", tt.entry)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ResolveImportEntryPath(%q) = %q, want error", tt.entry, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveImportEntryPath(%q) returned unexpected error: %v", tt.entry, err)
+			}
+		})
+	}
+}
+
+// filepathToSlash normalizes a path for comparison in tests regardless of
+// the OS the tests run on.
+func filepathToSlash(p string) string {
+	return strings.ReplaceAll(p, `\`, "/")
+}
@@ -3,7 +3,6 @@ package manager
 import (
 	"encoding/json"
 	"fmt"
-	"io"
 	"os"
 	filepath "path/filepath"
 	"strings"
@@ -17,6 +16,7 @@ import (
 	"github.com/trustwallet/go-primitives/coin"
 	"github.com/trustwallet/go-primitives/types"
 
+	"github.com/trustwallet/assets/internal/assetpath"
 	"github.com/trustwallet/assets/internal/config"
 )
 
@@ -148,8 +148,10 @@ func getAssetInfo(chain coin.Coin, tokenID string) (*info.AssetModel, error) {
 	return &assetModel, nil
 }
 
-// UploadDocument uploads a document file to an asset directory
-func UploadDocument(assetID, documentPath string) error {
+// UploadDocument uploads a document file to an asset directory. If
+// expectedSHA256 is non-empty, the source file's hash is verified against it
+// before the file is copied.
+func UploadDocument(assetID, documentPath, expectedSHA256 string) error {
 	// Parse asset ID
 	c, tokenID, err := asset.ParseID(assetID)
 	if err != nil {
@@ -167,7 +169,10 @@ func UploadDocument(assetID, documentPath string) error {
 	}
 
 	// Get asset directory path
-	assetDir := filepath.Join("blockchains", chain.Handle, "assets", tokenID)
+	assetDir, err := assetpath.GetAssetDirPath(chain.Handle, tokenID)
+	if err != nil {
+		return fmt.Errorf("invalid token id: %w", err)
+	}
 
 	// Check if asset directory exists
 	if _, err := os.Stat(assetDir); os.IsNotExist(err) {
@@ -176,47 +181,33 @@ func UploadDocument(assetID, documentPath string) error {
 
 	// Validate file extension
 	ext := strings.ToLower(filepath.Ext(documentPath))
-	allowedExtensions := map[string]bool{
-		".pdf":  true,
-		".doc":  true,
-		".docx": true,
-		".txt":  true,
-		".md":   true,
+	if !allowedDocumentExtensions[ext] {
+		return fmt.Errorf("unsupported file extension: %s. Supported extensions: .pdf, .doc, .docx, .txt, .md, .csv, .xlsx, .json", ext)
 	}
 
-	if !allowedExtensions[ext] {
-		return fmt.Errorf("unsupported file extension: %s. Supported extensions: .pdf, .doc, .docx, .txt, .md", ext)
+	actualSHA256, err := sha256File(documentPath)
+	if err != nil {
+		return err
 	}
-
-	// Get filename from path
-	filename := filepath.Base(documentPath)
-
-	// Create destination path
-	destPath := filepath.Join(assetDir, filename)
-
-	// Check if file already exists
-	if _, err := os.Stat(destPath); err == nil {
-		return fmt.Errorf("document file already exists: %s", destPath)
+	if expectedSHA256 != "" && !strings.EqualFold(actualSHA256, expectedSHA256) {
+		return fmt.Errorf("sha256 mismatch for %s: expected %s, got %s", documentPath, expectedSHA256, actualSHA256)
 	}
 
-	// Copy file
-	sourceFile, err := os.Open(documentPath)
-	if err != nil {
-		return fmt.Errorf("failed to open source file: %v", err)
+	meta := documentMeta{
+		SHA256:     actualSHA256,
+		UploadedAt: time.Now(),
+		Uploader:   currentUploader(),
 	}
-	defer sourceFile.Close()
 
-	destFile, err := os.Create(destPath)
+	destPath, err := copyDocumentFile(chain.Handle, tokenID, documentPath, meta)
 	if err != nil {
-		return fmt.Errorf("failed to create destination file: %v", err)
+		return err
 	}
-	defer destFile.Close()
 
-	_, err = io.Copy(destFile, sourceFile)
-	if err != nil {
-		// Clean up incomplete destination file on copy failure
+	if err := appendDocumentIndexEntry(chain.Handle, tokenID, filepath.Base(destPath), meta); err != nil {
 		os.Remove(destPath)
-		return fmt.Errorf("failed to copy file: %v", err)
+		os.Remove(destPath + ".meta.json")
+		return fmt.Errorf("failed to update documents index: %w", err)
 	}
 
 	fmt.Printf("Successfully uploaded document to: %s\n", destPath)
@@ -0,0 +1,302 @@
+package manager
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/trustwallet/go-primitives/asset"
+	"github.com/trustwallet/go-primitives/coin"
+
+	"github.com/trustwallet/assets/internal/assetpath"
+)
+
+var allowedDocumentExtensions = map[string]bool{
+	".pdf":  true,
+	".doc":  true,
+	".docx": true,
+	".txt":  true,
+	".md":   true,
+	".csv":  true,
+	".xlsx": true,
+	".json": true,
+}
+
+// documentMeta is written alongside an uploaded document as a
+// "<filename>.meta.json" sidecar and recorded in the per-asset documents
+// index, so uploads stay reproducible and verifiable.
+type documentMeta struct {
+	Title      string    `json:"title,omitempty"`
+	Category   string    `json:"category,omitempty"`
+	SHA256     string    `json:"sha256"`
+	UploadedAt time.Time `json:"uploaded_at"`
+	Uploader   string    `json:"uploader,omitempty"`
+}
+
+type documentIndexEntry struct {
+	Filename string `json:"filename"`
+	documentMeta
+}
+
+// documentManifestEntry describes one document to upload as part of a
+// UploadDocumentsFromManifest batch.
+type documentManifestEntry struct {
+	AssetID      string `json:"assetID"`
+	DocumentPath string `json:"documentPath"`
+	Title        string `json:"title"`
+	Category     string `json:"category"`
+	SHA256       string `json:"sha256"`
+}
+
+// UploadDocumentsFromManifest reads a JSON manifest listing documents to
+// upload and processes them transactionally: every destination asset
+// directory, source extension, and source hash is validated before any
+// file is copied, then all documents are copied or none are.
+func UploadDocumentsFromManifest(manifestPath string) error {
+	data, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	var entries []documentManifestEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	type plannedUpload struct {
+		chainHandle string
+		tokenID     string
+		entry       documentManifestEntry
+	}
+
+	planned := make([]plannedUpload, 0, len(entries))
+
+	for _, e := range entries {
+		c, tokenID, err := asset.ParseID(e.AssetID)
+		if err != nil {
+			return fmt.Errorf("manifest entry %s: failed to parse asset id: %w", e.AssetID, err)
+		}
+
+		chain, ok := coin.Coins[c]
+		if !ok {
+			return fmt.Errorf("manifest entry %s: unsupported blockchain: %d", e.AssetID, c)
+		}
+
+		assetDir, err := assetpath.GetAssetDirPath(chain.Handle, tokenID)
+		if err != nil {
+			return fmt.Errorf("manifest entry %s: %w", e.AssetID, err)
+		}
+		if _, err := os.Stat(assetDir); os.IsNotExist(err) {
+			return fmt.Errorf("manifest entry %s: asset directory does not exist: %s", e.AssetID, assetDir)
+		}
+
+		ext := strings.ToLower(filepath.Ext(e.DocumentPath))
+		if !allowedDocumentExtensions[ext] {
+			return fmt.Errorf("manifest entry %s: unsupported file extension: %s", e.AssetID, ext)
+		}
+
+		if e.SHA256 == "" {
+			return fmt.Errorf("manifest entry %s: sha256 is required", e.AssetID)
+		}
+
+		actual, err := sha256File(e.DocumentPath)
+		if err != nil {
+			return fmt.Errorf("manifest entry %s: %w", e.AssetID, err)
+		}
+		if !strings.EqualFold(actual, e.SHA256) {
+			return fmt.Errorf("manifest entry %s: sha256 mismatch: expected %s, got %s", e.AssetID, e.SHA256, actual)
+		}
+
+		planned = append(planned, plannedUpload{chainHandle: chain.Handle, tokenID: tokenID, entry: e})
+	}
+
+	var written []string
+	indexBackups := make(map[string]*documentIndexBackup)
+	rollback := func() {
+		for _, p := range written {
+			os.Remove(p)
+			os.Remove(p + ".meta.json")
+		}
+		for _, b := range indexBackups {
+			b.restore()
+		}
+	}
+
+	uploader := currentUploader()
+
+	for _, p := range planned {
+		meta := documentMeta{
+			Title:      p.entry.Title,
+			Category:   p.entry.Category,
+			SHA256:     p.entry.SHA256,
+			UploadedAt: time.Now(),
+			Uploader:   uploader,
+		}
+
+		destPath, err := copyDocumentFile(p.chainHandle, p.tokenID, p.entry.DocumentPath, meta)
+		if err != nil {
+			rollback()
+			return fmt.Errorf("manifest entry %s: %w", p.entry.AssetID, err)
+		}
+		written = append(written, destPath)
+
+		indexPath, err := assetpath.GetAssetDocumentsIndexPath(p.chainHandle, p.tokenID)
+		if err != nil {
+			rollback()
+			return fmt.Errorf("manifest entry %s: %w", p.entry.AssetID, err)
+		}
+		if _, ok := indexBackups[indexPath]; !ok {
+			backup, err := backupDocumentIndex(indexPath)
+			if err != nil {
+				rollback()
+				return fmt.Errorf("manifest entry %s: failed to back up documents index: %w", p.entry.AssetID, err)
+			}
+			indexBackups[indexPath] = backup
+		}
+
+		if err := appendDocumentIndexEntry(p.chainHandle, p.tokenID, filepath.Base(destPath), meta); err != nil {
+			rollback()
+			return fmt.Errorf("manifest entry %s: failed to update documents index: %w", p.entry.AssetID, err)
+		}
+	}
+
+	return nil
+}
+
+// documentIndexBackup captures a documents.json index's content before a
+// manifest run touches it, so the run can be rolled back to its prior state
+// if a later entry fails.
+type documentIndexBackup struct {
+	path    string
+	existed bool
+	data    []byte
+}
+
+// backupDocumentIndex snapshots the documents.json index at indexPath, if it
+// exists, before it is modified.
+func backupDocumentIndex(indexPath string) (*documentIndexBackup, error) {
+	data, err := os.ReadFile(indexPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &documentIndexBackup{path: indexPath, existed: false}, nil
+		}
+		return nil, fmt.Errorf("failed to read documents index: %w", err)
+	}
+
+	return &documentIndexBackup{path: indexPath, existed: true, data: data}, nil
+}
+
+// restore writes the index back to its pre-run content, or removes it if it
+// did not exist before the run.
+func (b *documentIndexBackup) restore() {
+	if b.existed {
+		os.WriteFile(b.path, b.data, 0o644)
+		return
+	}
+	os.Remove(b.path)
+}
+
+// copyDocumentFile copies documentPath into tokenID's asset directory and
+// writes a "<filename>.meta.json" sidecar next to it, returning the
+// destination path.
+func copyDocumentFile(chainHandle, tokenID, documentPath string, meta documentMeta) (string, error) {
+	destPath, err := assetpath.GetAssetDocumentPath(chainHandle, tokenID, filepath.Base(documentPath))
+	if err != nil {
+		return "", fmt.Errorf("invalid document path %s: %w", documentPath, err)
+	}
+
+	if _, err := os.Stat(destPath); err == nil {
+		return "", fmt.Errorf("document file already exists: %s", destPath)
+	}
+
+	sourceFile, err := os.Open(documentPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open source file: %v", err)
+	}
+	defer sourceFile.Close()
+
+	destFile, err := os.Create(destPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to create destination file: %v", err)
+	}
+	defer destFile.Close()
+
+	if _, err := io.Copy(destFile, sourceFile); err != nil {
+		os.Remove(destPath)
+		return "", fmt.Errorf("failed to copy file: %v", err)
+	}
+
+	metaBytes, err := json.MarshalIndent(&meta, "", "  ")
+	if err != nil {
+		os.Remove(destPath)
+		return "", fmt.Errorf("failed to marshal document metadata: %v", err)
+	}
+
+	if err := os.WriteFile(destPath+".meta.json", metaBytes, 0o644); err != nil {
+		os.Remove(destPath)
+		return "", fmt.Errorf("failed to write document metadata: %v", err)
+	}
+
+	return destPath, nil
+}
+
+// appendDocumentIndexEntry records filename/meta in tokenID's documents.json
+// index, which the existing tokenlist/info tooling can reference.
+func appendDocumentIndexEntry(chainHandle, tokenID, filename string, meta documentMeta) error {
+	indexPath, err := assetpath.GetAssetDocumentsIndexPath(chainHandle, tokenID)
+	if err != nil {
+		return fmt.Errorf("failed to resolve documents index path: %w", err)
+	}
+
+	var index []documentIndexEntry
+	if data, err := os.ReadFile(indexPath); err == nil {
+		if err := json.Unmarshal(data, &index); err != nil {
+			return fmt.Errorf("failed to parse existing documents index: %w", err)
+		}
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read documents index: %w", err)
+	}
+
+	index = append(index, documentIndexEntry{Filename: filename, documentMeta: meta})
+
+	data, err := json.MarshalIndent(&index, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal documents index: %w", err)
+	}
+
+	return os.WriteFile(indexPath, data, 0o644)
+}
+
+// currentUploader returns the identity of the user running the upload, for
+// recording in documentMeta.Uploader. It falls back to the USER environment
+// variable, and to an empty string, rather than failing the upload, since
+// this is provenance metadata and not something worth blocking on.
+func currentUploader() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+
+	return os.Getenv("USER")
+}
+
+func sha256File(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open file for hashing: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("failed to hash file: %w", err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
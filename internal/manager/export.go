@@ -0,0 +1,380 @@
+package manager
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/trustwallet/assets-go-libs/path"
+	"github.com/trustwallet/assets-go-libs/validation/info"
+	"github.com/trustwallet/assets-go-libs/validation/tokenlist"
+	"github.com/trustwallet/go-primitives/coin"
+
+	"github.com/trustwallet/assets/internal/assetpath"
+)
+
+// exportSchemaVersion is bumped whenever the archive layout produced by
+// ExportAssets changes in a way that ImportAssets needs to know about.
+const exportSchemaVersion = 1
+
+const exportManifestName = "manifest.json"
+
+// ExportOptions controls which tokens ExportAssets archives and whether the
+// resulting tar stream is gzip-compressed.
+type ExportOptions struct {
+	// TokenIDs restricts the export to the given tokens. A nil or empty
+	// slice exports every token under the chain.
+	TokenIDs []string
+	// Since restricts the export to tokens whose info.json was modified at
+	// or after this time. A zero value disables the filter.
+	Since time.Time
+	// Compress wraps the output in a gzip writer. Callers that derive the
+	// archive destination from a filename should set this when the
+	// filename ends in ".gz".
+	Compress bool
+}
+
+// ImportOptions controls how ImportAssets writes an archive back to disk.
+type ImportOptions struct {
+	// Force allows ImportAssets to overwrite files that already exist.
+	Force bool
+}
+
+type exportManifest struct {
+	ChainHandle   string    `json:"chain_handle"`
+	SchemaVersion int       `json:"schema_version"`
+	TokenCount    int       `json:"token_count"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ExportAssets serializes a chain's asset catalog - every info.json,
+// logo.png, uploaded document, and both token lists - into a single tar
+// stream written to writer. It pairs naturally with UploadDocument, since
+// any documents attached to a token travel with it in the archive.
+func ExportAssets(chain coin.Coin, writer io.Writer, opts ExportOptions) (err error) {
+	tokenIDs, err := selectExportTokenIDs(chain, opts)
+	if err != nil {
+		return err
+	}
+
+	var gz *gzip.Writer
+	out := writer
+	if opts.Compress {
+		gz = gzip.NewWriter(writer)
+		out = gz
+	}
+
+	tw := tar.NewWriter(out)
+	defer func() {
+		if closeErr := tw.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("failed to flush tar stream: %w", closeErr)
+		}
+		if gz != nil {
+			if closeErr := gz.Close(); closeErr != nil && err == nil {
+				err = fmt.Errorf("failed to flush gzip stream: %w", closeErr)
+			}
+		}
+	}()
+
+	manifestBytes, err := json.Marshal(&exportManifest{
+		ChainHandle:   chain.Handle,
+		SchemaVersion: exportSchemaVersion,
+		TokenCount:    len(tokenIDs),
+		CreatedAt:     time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal export manifest: %w", err)
+	}
+
+	if err := writeTarEntry(tw, exportManifestName, manifestBytes); err != nil {
+		return fmt.Errorf("failed to write export manifest: %w", err)
+	}
+
+	assetsDir := filepath.Join("blockchains", chain.Handle, "assets")
+	for _, tokenID := range tokenIDs {
+		archiveDir := filepath.Join("assets", tokenID)
+		if err := addDirToTar(tw, filepath.Join(assetsDir, tokenID), archiveDir); err != nil {
+			return fmt.Errorf("failed to archive asset %s: %w", tokenID, err)
+		}
+	}
+
+	for _, t := range []path.TokenListType{path.TokenlistDefault, path.TokenlistExtended} {
+		tokenListPath := path.GetTokenListPath(chain.Handle, t)
+		if _, err := os.Stat(tokenListPath); err != nil {
+			continue
+		}
+		if err := addFileToTar(tw, tokenListPath, filepath.Base(tokenListPath)); err != nil {
+			return fmt.Errorf("failed to archive %s: %w", filepath.Base(tokenListPath), err)
+		}
+	}
+
+	return nil
+}
+
+func selectExportTokenIDs(chain coin.Coin, opts ExportOptions) ([]string, error) {
+	assetsDir := filepath.Join("blockchains", chain.Handle, "assets")
+
+	entries, err := os.ReadDir(assetsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read assets directory: %w", err)
+	}
+
+	filter := make(map[string]bool, len(opts.TokenIDs))
+	for _, id := range opts.TokenIDs {
+		filter[id] = true
+	}
+
+	tokenIDs := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		if len(filter) > 0 && !filter[e.Name()] {
+			continue
+		}
+		if !opts.Since.IsZero() {
+			fi, err := os.Stat(path.GetAssetInfoPath(chain.Handle, e.Name()))
+			if err != nil || fi.ModTime().Before(opts.Since) {
+				continue
+			}
+		}
+		tokenIDs = append(tokenIDs, e.Name())
+	}
+	sort.Strings(tokenIDs)
+
+	return tokenIDs, nil
+}
+
+// importFileBackup captures a destination file's content before ImportAssets
+// overwrites or creates it, so the import can be rolled back to its prior
+// state - including restoring an overwritten file, not just deleting a new
+// one - if a later entry fails.
+type importFileBackup struct {
+	path    string
+	existed bool
+	data    []byte
+}
+
+// backupImportFile snapshots the file at destPath, if it exists, before
+// ImportAssets writes to it.
+func backupImportFile(destPath string) (*importFileBackup, error) {
+	data, err := os.ReadFile(destPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &importFileBackup{path: destPath, existed: false}, nil
+		}
+		return nil, err
+	}
+
+	return &importFileBackup{path: destPath, existed: true, data: data}, nil
+}
+
+// restore writes the file back to its pre-import content, or removes it if
+// it did not exist before the import.
+func (b *importFileBackup) restore() {
+	if b.existed {
+		os.WriteFile(b.path, b.data, 0o644)
+		return
+	}
+	os.Remove(b.path)
+}
+
+// ImportAssets reads a tar stream produced by ExportAssets and writes its
+// contents back under blockchains/<chain.Handle>. Gzip compression is
+// detected automatically from the stream's magic bytes. Entries are processed
+// one at a time in archive order: every info.json and token list entry is
+// validated against the existing AssetModel / tokenlist Model before that
+// entry is written, every entry name is resolved through
+// assetpath.ResolveImportEntryPath so a crafted or corrupted archive cannot
+// write outside the chain's directory, and every destination file is backed
+// up before it is written so a failure partway through the archive restores
+// each file - including ones overwritten with ImportOptions.Force - to its
+// pre-import state.
+func ImportAssets(chain coin.Coin, reader io.Reader, opts ImportOptions) error {
+	br := bufio.NewReader(reader)
+
+	in, err := maybeGunzip(br)
+	if err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(in)
+
+	backups := make(map[string]*importFileBackup)
+	rollback := func() {
+		for _, b := range backups {
+			b.restore()
+		}
+	}
+
+	manifestSeen := false
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			rollback()
+			return fmt.Errorf("failed to read archive entry: %w", err)
+		}
+
+		if hdr.Typeflag == tar.TypeDir {
+			continue
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			rollback()
+			return fmt.Errorf("unsupported archive entry type for %s", hdr.Name)
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			rollback()
+			return fmt.Errorf("failed to read archive entry %s: %w", hdr.Name, err)
+		}
+
+		if hdr.Name == exportManifestName {
+			var manifest exportManifest
+			if err := json.Unmarshal(data, &manifest); err != nil {
+				rollback()
+				return fmt.Errorf("invalid export manifest: %w", err)
+			}
+			if manifest.SchemaVersion != exportSchemaVersion {
+				rollback()
+				return fmt.Errorf("unsupported export schema version: %d", manifest.SchemaVersion)
+			}
+			manifestSeen = true
+			continue
+		}
+
+		if err := validateImportEntry(hdr.Name, data); err != nil {
+			rollback()
+			return err
+		}
+
+		destPath, err := assetpath.ResolveImportEntryPath(chain.Handle, hdr.Name)
+		if err != nil {
+			rollback()
+			return fmt.Errorf("refusing to write unsafe archive entry: %w", err)
+		}
+
+		if _, err := os.Stat(destPath); err == nil && !opts.Force {
+			rollback()
+			return fmt.Errorf("refusing to overwrite existing file %s, set ImportOptions.Force to override", destPath)
+		}
+
+		if _, ok := backups[destPath]; !ok {
+			backup, err := backupImportFile(destPath)
+			if err != nil {
+				rollback()
+				return fmt.Errorf("failed to back up %s: %w", destPath, err)
+			}
+			backups[destPath] = backup
+		}
+
+		if err := os.MkdirAll(filepath.Dir(destPath), 0o755); err != nil {
+			rollback()
+			return fmt.Errorf("failed to create directory for %s: %w", destPath, err)
+		}
+		if err := os.WriteFile(destPath, data, 0o644); err != nil {
+			rollback()
+			return fmt.Errorf("failed to write %s: %w", destPath, err)
+		}
+	}
+
+	if !manifestSeen {
+		rollback()
+		return fmt.Errorf("archive is missing %s", exportManifestName)
+	}
+
+	return nil
+}
+
+func maybeGunzip(r *bufio.Reader) (io.Reader, error) {
+	magic, err := r.Peek(2)
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to inspect archive stream: %w", err)
+	}
+	if len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open gzip stream: %w", err)
+		}
+		return gz, nil
+	}
+
+	return r, nil
+}
+
+func validateImportEntry(name string, data []byte) error {
+	switch {
+	case strings.HasSuffix(name, "/info.json"):
+		var assetModel info.AssetModel
+		if err := json.Unmarshal(data, &assetModel); err != nil {
+			return fmt.Errorf("invalid info.json at %s: %w", name, err)
+		}
+		if err := assetModel.Validate(); err != nil {
+			return fmt.Errorf("invalid info.json at %s: %w", name, err)
+		}
+	case strings.HasSuffix(name, "tokenlist.json"), strings.HasSuffix(name, "tokenlist-extended.json"):
+		var list tokenlist.Model
+		if err := json.Unmarshal(data, &list); err != nil {
+			return fmt.Errorf("invalid token list at %s: %w", name, err)
+		}
+		if err := list.Validate(); err != nil {
+			return fmt.Errorf("invalid token list at %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func writeTarEntry(tw *tar.Writer, name string, data []byte) error {
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+
+	return err
+}
+
+func addFileToTar(tw *tar.Writer, sourcePath, archiveName string) error {
+	data, err := os.ReadFile(sourcePath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", sourcePath, err)
+	}
+
+	return writeTarEntry(tw, archiveName, data)
+}
+
+func addDirToTar(tw *tar.Writer, sourceDir, archiveDir string) error {
+	return filepath.Walk(sourceDir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, p)
+		if err != nil {
+			return fmt.Errorf("failed to resolve relative path for %s: %w", p, err)
+		}
+
+		return addFileToTar(tw, p, filepath.ToSlash(filepath.Join(archiveDir, rel)))
+	})
+}
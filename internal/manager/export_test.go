@@ -0,0 +1,215 @@
+package manager
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/trustwallet/assets-go-libs/validation/info"
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+type tarEntry struct {
+	name string
+	data []byte
+}
+
+func buildTar(t *testing.T, entries []tarEntry) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, e := range entries {
+		hdr := &tar.Header{Name: e.name, Mode: 0o644, Size: int64(len(e.data))}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatalf("failed to write tar header for %s: %v", e.name, err)
+		}
+		if _, err := tw.Write(e.data); err != nil {
+			t.Fatalf("failed to write tar data for %s: %v", e.name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("failed to close tar writer: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func manifestEntry(t *testing.T, chainHandle string, tokenCount int) tarEntry {
+	t.Helper()
+
+	data, err := json.Marshal(&exportManifest{
+		ChainHandle:   chainHandle,
+		SchemaVersion: exportSchemaVersion,
+		TokenCount:    tokenCount,
+		CreatedAt:     time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal export manifest: %v", err)
+	}
+
+	return tarEntry{name: exportManifestName, data: data}
+}
+
+// validAssetInfoJSON builds minimal but complete info.json bytes, the same
+// shape CreateAssetInfoJSONTemplate produces for a new asset.
+func validAssetInfoJSON(t *testing.T, tokenID string) []byte {
+	t.Helper()
+
+	empty := ""
+	zero := 0
+	model := info.AssetModel{
+		Name:     &empty,
+		Type:     &empty,
+		Symbol:   &empty,
+		Decimals: &zero,
+		Website:  &empty,
+		Explorer: &empty,
+		Status:   &empty,
+		ID:       &tokenID,
+		Links:    []info.Link{{Name: &empty, URL: &empty}},
+		Tags:     []string{""},
+	}
+
+	data, err := json.Marshal(&model)
+	if err != nil {
+		t.Fatalf("failed to marshal asset info: %v", err)
+	}
+
+	return data
+}
+
+func chdirTemp(t *testing.T) {
+	t.Helper()
+
+	dir := t.TempDir()
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get working directory: %v", err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("failed to chdir into temp dir: %v", err)
+	}
+	t.Cleanup(func() {
+		os.Chdir(cwd)
+	})
+}
+
+func TestImportAssets_RejectsTraversalEntry(t *testing.T) {
+	chdirTemp(t)
+	chain := coin.Coin{ID: 60, Handle: "ethereum"}
+
+	archive := buildTar(t, []tarEntry{
+		manifestEntry(t, chain.Handle, 0),
+		{name: "../../../../tmp/evil-from-export-test", data: []byte("malicious")},
+	})
+
+	err := ImportAssets(chain, bytes.NewReader(archive), ImportOptions{})
+	if err == nil {
+		t.Fatal("ImportAssets succeeded for an archive with a traversal entry, want error")
+	}
+
+	if _, statErr := os.Stat(filepath.Join(os.TempDir(), "evil-from-export-test")); statErr == nil {
+		t.Fatal("traversal entry was written outside the chain directory")
+	}
+}
+
+func TestImportAssets_RejectsAbsoluteEntry(t *testing.T) {
+	chdirTemp(t)
+	chain := coin.Coin{ID: 60, Handle: "ethereum"}
+
+	archive := buildTar(t, []tarEntry{
+		manifestEntry(t, chain.Handle, 0),
+		{name: "/etc/passwd-from-export-test", data: []byte("malicious")},
+	})
+
+	err := ImportAssets(chain, bytes.NewReader(archive), ImportOptions{})
+	if err == nil {
+		t.Fatal("ImportAssets succeeded for an archive with an absolute entry, want error")
+	}
+}
+
+func TestImportAssets_InvalidInfoJSONAbortsAndRollsBack(t *testing.T) {
+	chdirTemp(t)
+	chain := coin.Coin{ID: 60, Handle: "ethereum"}
+
+	archive := buildTar(t, []tarEntry{
+		manifestEntry(t, chain.Handle, 2),
+		{name: "assets/GOOD2/info.json", data: validAssetInfoJSON(t, "GOOD2")},
+		{name: "assets/BAD2/info.json", data: []byte("{}")},
+	})
+
+	err := ImportAssets(chain, bytes.NewReader(archive), ImportOptions{})
+	if err == nil {
+		t.Fatal("ImportAssets succeeded for an archive with an invalid info.json, want error")
+	}
+
+	if _, statErr := os.Stat(filepath.Join("blockchains", chain.Handle, "assets", "GOOD2", "info.json")); !os.IsNotExist(statErr) {
+		t.Fatalf("entry written before the invalid entry was not rolled back: stat err = %v", statErr)
+	}
+}
+
+func TestImportAssets_ForceOverwriteRollback(t *testing.T) {
+	chdirTemp(t)
+	chain := coin.Coin{ID: 60, Handle: "ethereum"}
+
+	existingPath := filepath.Join("blockchains", chain.Handle, "assets", "GOOD", "info.json")
+	if err := os.MkdirAll(filepath.Dir(existingPath), 0o755); err != nil {
+		t.Fatalf("failed to create pre-existing asset directory: %v", err)
+	}
+	original := []byte("original-content")
+	if err := os.WriteFile(existingPath, original, 0o644); err != nil {
+		t.Fatalf("failed to seed pre-existing info.json: %v", err)
+	}
+
+	archive := buildTar(t, []tarEntry{
+		manifestEntry(t, chain.Handle, 2),
+		{name: "assets/GOOD/info.json", data: validAssetInfoJSON(t, "GOOD")},
+		{name: "assets/BAD/info.json", data: []byte("{}")},
+	})
+
+	err := ImportAssets(chain, bytes.NewReader(archive), ImportOptions{Force: true})
+	if err == nil {
+		t.Fatal("ImportAssets succeeded for an archive with an invalid info.json, want error")
+	}
+
+	got, readErr := os.ReadFile(existingPath)
+	if readErr != nil {
+		t.Fatalf("pre-existing file was deleted by rollback instead of restored: %v", readErr)
+	}
+	if !bytes.Equal(got, original) {
+		t.Fatalf("pre-existing file content after rollback = %q, want %q", got, original)
+	}
+}
+
+func TestImportAssets_GzipAutoDetect(t *testing.T) {
+	chdirTemp(t)
+	chain := coin.Coin{ID: 60, Handle: "ethereum"}
+
+	archive := buildTar(t, []tarEntry{
+		manifestEntry(t, chain.Handle, 1),
+		{name: "assets/GZ/info.json", data: validAssetInfoJSON(t, "GZ")},
+	})
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write(archive); err != nil {
+		t.Fatalf("failed to gzip archive: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("failed to close gzip writer: %v", err)
+	}
+
+	if err := ImportAssets(chain, bytes.NewReader(buf.Bytes()), ImportOptions{}); err != nil {
+		t.Fatalf("ImportAssets failed for a gzip-compressed archive: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join("blockchains", chain.Handle, "assets", "GZ", "info.json")); err != nil {
+		t.Fatalf("gzip-compressed archive was not imported: %v", err)
+	}
+}